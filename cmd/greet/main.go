@@ -0,0 +1,108 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/example/go-workflow-template/pkg/greet"
+	"github.com/example/go-workflow-template/pkg/greetserver"
+)
+
+var version = "dev"
+
+func main() {
+	if len(os.Args) > 1 && os.Args[1] == "serve" {
+		if err := runServe(os.Args[2:]); err != nil {
+			fmt.Fprintln(os.Stderr, "greet serve:", err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	showVersion := flag.Bool("version", false, "show version")
+	lang := flag.String("lang", "", "locale to greet in, e.g. en, es, fr, de, ja (default: detected from LANG/LC_ALL)")
+	format := flag.String("format", "", "output formatter to use, e.g. plain, json, yaml, xml, ascii-banner (default: plain, locale-aware)")
+	logFormat := flag.String("log-format", "off", "structured log format for greet calls: \"off\" (default), \"json\", or \"text\"")
+	otlpEndpoint := flag.String("otlp-endpoint", "", "host:port of an OTLP/gRPC collector to export traces and metrics to (default: disabled)")
+	flag.Parse()
+
+	if *showVersion {
+		fmt.Println(version)
+		return
+	}
+
+	ctx := context.Background()
+	shutdown, err := setupObservability(ctx, *logFormat, *otlpEndpoint)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "greet:", err)
+		os.Exit(1)
+	}
+	defer shutdown(ctx)
+
+	name := "World"
+	if flag.NArg() > 0 {
+		name = flag.Arg(0)
+	}
+
+	if *format != "" {
+		out, err := greet.FormatContext(ctx, *format, name)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "greet:", err)
+			os.Exit(1)
+		}
+		fmt.Println(out)
+		return
+	}
+
+	var opts []greet.Option
+	if *lang != "" {
+		opts = append(opts, greet.WithLocale(*lang))
+	}
+	g := greet.NewGreeter(opts...)
+
+	if err := g.GreetContext(ctx, os.Stdout, name); err != nil {
+		fmt.Fprintln(os.Stderr, "greet:", err)
+		os.Exit(1)
+	}
+}
+
+func runServe(args []string) error {
+	fs := flag.NewFlagSet("serve", flag.ExitOnError)
+	httpAddr := fs.String("http-addr", ":8080", "address to serve HTTP greetings on, empty to disable")
+	grpcAddr := fs.String("grpc-addr", ":9090", "address to serve gRPC greetings on, empty to disable")
+	lang := fs.String("lang", "", "locale to greet in (default: detected from LANG/LC_ALL)")
+	logFormat := fs.String("log-format", "off", "structured log format for greet calls: \"off\" (default), \"json\", or \"text\"")
+	otlpEndpoint := fs.String("otlp-endpoint", "", "host:port of an OTLP/gRPC collector to export traces and metrics to (default: disabled)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	shutdown, err := setupObservability(ctx, *logFormat, *otlpEndpoint)
+	if err != nil {
+		return err
+	}
+	defer shutdown(ctx)
+
+	var opts []greet.Option
+	if *lang != "" {
+		opts = append(opts, greet.WithLocale(*lang))
+	}
+
+	srv, err := greetserver.New(greetserver.Config{
+		HTTPAddr: *httpAddr,
+		GRPCAddr: *grpcAddr,
+	}, greet.NewGreeter(opts...))
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("serving HTTP on %s, gRPC on %s\n", *httpAddr, *grpcAddr)
+	return srv.Serve(ctx)
+}
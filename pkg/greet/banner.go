@@ -0,0 +1,75 @@
+package greet
+
+import "strings"
+
+// bannerGlyphs maps a character to its 5-row, 3-column block representation.
+// Characters without a glyph render as blank space.
+var bannerGlyphs = map[rune][5]string{
+	'A': {" # ", "# #", "###", "# #", "# #"},
+	'B': {"## ", "# #", "## ", "# #", "## "},
+	'C': {" ##", "#  ", "#  ", "#  ", " ##"},
+	'D': {"## ", "# #", "# #", "# #", "## "},
+	'E': {"###", "#  ", "## ", "#  ", "###"},
+	'F': {"###", "#  ", "## ", "#  ", "#  "},
+	'G': {" ##", "#  ", "# #", "# #", " ##"},
+	'H': {"# #", "# #", "###", "# #", "# #"},
+	'I': {"###", " # ", " # ", " # ", "###"},
+	'J': {"  #", "  #", "  #", "# #", " # "},
+	'K': {"# #", "## ", "#  ", "## ", "# #"},
+	'L': {"#  ", "#  ", "#  ", "#  ", "###"},
+	'M': {"# #", "###", "###", "# #", "# #"},
+	'N': {"# #", "###", "###", "###", "# #"},
+	'O': {" # ", "# #", "# #", "# #", " # "},
+	'P': {"## ", "# #", "## ", "#  ", "#  "},
+	'Q': {" # ", "# #", "# #", "###", " ##"},
+	'R': {"## ", "# #", "## ", "## ", "# #"},
+	'S': {" ##", "#  ", " # ", "  #", "## "},
+	'T': {"###", " # ", " # ", " # ", " # "},
+	'U': {"# #", "# #", "# #", "# #", " # "},
+	'V': {"# #", "# #", "# #", " # ", " # "},
+	'W': {"# #", "# #", "# #", "###", "# #"},
+	'X': {"# #", "# #", " # ", "# #", "# #"},
+	'Y': {"# #", "# #", " # ", " # ", " # "},
+	'Z': {"###", "  #", " # ", "#  ", "###"},
+	'0': {" # ", "# #", "# #", "# #", " # "},
+	'1': {" # ", "## ", " # ", " # ", "###"},
+	'2': {"## ", "  #", " # ", "#  ", "###"},
+	'3': {"## ", "  #", " # ", "  #", "## "},
+	'4': {"# #", "# #", "###", "  #", "  #"},
+	'5': {"###", "#  ", "## ", "  #", "## "},
+	'6': {" ##", "#  ", "## ", "# #", " # "},
+	'7': {"###", "  #", " # ", "#  ", "#  "},
+	'8': {" # ", "# #", " # ", "# #", " # "},
+	'9': {" # ", "# #", " ##", "  #", " # "},
+	' ': {"   ", "   ", "   ", "   ", "   "},
+	'!': {" # ", " # ", " # ", "   ", " # "},
+	',': {"   ", "   ", "   ", " # ", "#  "},
+	'.': {"   ", "   ", "   ", "   ", " # "},
+	'?': {"## ", "  #", " # ", "   ", " # "},
+}
+
+const bannerBlankGlyph = "   "
+
+// banner renders message as a 5-row block-letter ASCII banner.
+func banner(message string) string {
+	rows := [5]strings.Builder{}
+
+	for i, r := range message {
+		glyph, ok := bannerGlyphs[r]
+		if !ok {
+			glyph = [5]string{bannerBlankGlyph, bannerBlankGlyph, bannerBlankGlyph, bannerBlankGlyph, bannerBlankGlyph}
+		}
+		for row := range rows {
+			if i > 0 {
+				rows[row].WriteByte(' ')
+			}
+			rows[row].WriteString(glyph[row])
+		}
+	}
+
+	lines := make([]string, len(rows))
+	for i, row := range rows {
+		lines[i] = row.String()
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,113 @@
+package greet
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"strings"
+	"sync"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Formatter renders a greeting for name in a particular output shape.
+type Formatter interface {
+	Format(name string) (string, error)
+}
+
+// FormatterFunc adapts a plain function to the Formatter interface.
+type FormatterFunc func(name string) (string, error)
+
+// Format calls f(name).
+func (f FormatterFunc) Format(name string) (string, error) {
+	return f(name)
+}
+
+var (
+	formattersMu sync.RWMutex
+	formatters   = map[string]Formatter{}
+)
+
+// Register adds f to the formatter registry under name, overwriting any
+// formatter previously registered under the same name. It is typically
+// called from an init function.
+func Register(name string, f Formatter) {
+	formattersMu.Lock()
+	defer formattersMu.Unlock()
+	formatters[name] = f
+}
+
+// Format renders a greeting for name using the formatter registered under
+// formatterName. It returns an error if no such formatter is registered.
+func Format(formatterName, name string) (string, error) {
+	formattersMu.RLock()
+	f, ok := formatters[formatterName]
+	formattersMu.RUnlock()
+	if !ok {
+		return "", fmt.Errorf("greet: no formatter registered for %q", formatterName)
+	}
+	return f.Format(name)
+}
+
+func init() {
+	Register("plain", FormatterFunc(formatPlain))
+	Register("json", FormatterFunc(formatJSON))
+	Register("yaml", FormatterFunc(formatYAML))
+	Register("xml", FormatterFunc(formatXML))
+	Register("ascii-banner", FormatterFunc(formatASCIIBanner))
+}
+
+func formatPlain(name string) (string, error) {
+	if name == "" {
+		return "", ErrEmptyName
+	}
+	return fmt.Sprintf("Hello, %s!", name), nil
+}
+
+type greeting struct {
+	Greeting  string `json:"greeting" xml:"greeting" yaml:"greeting"`
+	Recipient string `json:"recipient" xml:"recipient" yaml:"recipient"`
+}
+
+func formatJSON(name string) (string, error) {
+	if name == "" {
+		return "", ErrEmptyName
+	}
+	b, err := json.Marshal(greeting{Greeting: "Hello", Recipient: name})
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+func formatYAML(name string) (string, error) {
+	if name == "" {
+		return "", ErrEmptyName
+	}
+	b, err := yaml.Marshal(greeting{Greeting: "Hello", Recipient: name})
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+func formatXML(name string) (string, error) {
+	if name == "" {
+		return "", ErrEmptyName
+	}
+	b, err := xml.Marshal(struct {
+		XMLName xml.Name `xml:"message"`
+		greeting
+	}{greeting: greeting{Greeting: "Hello", Recipient: name}})
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+func formatASCIIBanner(name string) (string, error) {
+	if name == "" {
+		return "", ErrEmptyName
+	}
+	return banner(fmt.Sprintf("HELLO, %s!", strings.ToUpper(name))), nil
+}
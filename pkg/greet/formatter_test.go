@@ -0,0 +1,116 @@
+package greet
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestFormatBuiltins(t *testing.T) {
+	tests := []struct {
+		formatter string
+		name      string
+		want      string
+	}{
+		{"plain", "Bob", "Hello, Bob!"},
+		{"json", "Bob", `{"greeting":"Hello","recipient":"Bob"}`},
+		{"yaml", "Bob", "greeting: Hello\nrecipient: Bob\n"},
+		{"xml", "Bob", `<message><greeting>Hello</greeting><recipient>Bob</recipient></message>`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.formatter, func(t *testing.T) {
+			got, err := Format(tt.formatter, tt.name)
+			if err != nil {
+				t.Fatalf("Format(%q, %q) returned error: %v", tt.formatter, tt.name, err)
+			}
+			if got != tt.want {
+				t.Errorf("Format(%q, %q) = %q, want %q", tt.formatter, tt.name, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatASCIIBanner(t *testing.T) {
+	got, err := Format("ascii-banner", "Hi")
+	if err != nil {
+		t.Fatalf("Format(ascii-banner, Hi) returned error: %v", err)
+	}
+	if lines := strings.Count(got, "\n") + 1; lines != 5 {
+		t.Errorf("ascii-banner has %d lines, want 5", lines)
+	}
+	if !strings.Contains(got, "#") {
+		t.Errorf("ascii-banner output has no glyph content: %q", got)
+	}
+}
+
+func TestFormatYAMLQuotesAmbiguousScalars(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"true", "greeting: Hello\nrecipient: \"true\"\n"},
+		{"123", "greeting: Hello\nrecipient: \"123\"\n"},
+		{"Bob: <x>", "greeting: Hello\nrecipient: 'Bob: <x>'\n"},
+	}
+	for _, tt := range tests {
+		got, err := Format("yaml", tt.name)
+		if err != nil {
+			t.Fatalf("Format(yaml, %q) returned error: %v", tt.name, err)
+		}
+		if got != tt.want {
+			t.Errorf("Format(yaml, %q) = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestBannerGlyphsDistinct(t *testing.T) {
+	// U and V are easy to confuse in a blocky font; make sure they render
+	// differently rather than reusing the same glyph.
+	if bannerGlyphs['U'] == bannerGlyphs['V'] {
+		t.Error("glyphs for 'U' and 'V' are identical, want visually distinct shapes")
+	}
+}
+
+func TestFormatEmptyName(t *testing.T) {
+	for _, formatter := range []string{"plain", "json", "yaml", "xml", "ascii-banner"} {
+		if _, err := Format(formatter, ""); !errors.Is(err, ErrEmptyName) {
+			t.Errorf("Format(%q, \"\") error = %v, want %v", formatter, err, ErrEmptyName)
+		}
+	}
+}
+
+func TestFormatUnknownFormatter(t *testing.T) {
+	if _, err := Format("toml", "Bob"); err == nil {
+		t.Error("Format(toml, Bob) expected an error for an unregistered formatter")
+	}
+}
+
+func TestRegisterCustomFormatter(t *testing.T) {
+	Register("shout", FormatterFunc(func(name string) (string, error) {
+		if name == "" {
+			return "", ErrEmptyName
+		}
+		return strings.ToUpper("hello, " + name + "!"), nil
+	}))
+
+	got, err := Format("shout", "bob")
+	if err != nil {
+		t.Fatalf("Format(shout, bob) returned error: %v", err)
+	}
+	if want := "HELLO, BOB!"; got != want {
+		t.Errorf("Format(shout, bob) = %q, want %q", got, want)
+	}
+}
+
+func BenchmarkFormatters(b *testing.B) {
+	for _, formatter := range []string{"plain", "json", "yaml", "xml", "ascii-banner"} {
+		b.Run(formatter, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				if _, err := Format(formatter, "Benchmark"); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
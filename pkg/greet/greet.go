@@ -1,7 +1,65 @@
 // Package greet provides greeting functions.
 package greet
 
-// Hello returns a greeting for the given name.
-func Hello(name string) string {
-	return "Hello, " + name + "!"
+import (
+	"errors"
+	"fmt"
+	"io"
+)
+
+// ErrEmptyName is returned when a greeting is requested for an empty name.
+var ErrEmptyName = errors.New("greet: name must not be empty")
+
+// DefaultLocale is used when no locale is configured and none can be
+// detected from the environment.
+const DefaultLocale = "en"
+
+// Greeter produces locale-aware greetings.
+type Greeter struct {
+	locale string
+}
+
+// Option configures a Greeter.
+type Option func(*Greeter)
+
+// WithLocale sets the Greeter's locale, e.g. "es" or "fr". If locale has
+// no registered translation, the Greeter falls back to DefaultLocale.
+func WithLocale(locale string) Option {
+	return func(g *Greeter) {
+		g.locale = locale
+	}
+}
+
+// NewGreeter builds a Greeter. With no options, the locale is detected from
+// the LANG/LC_ALL environment variables, falling back to DefaultLocale.
+func NewGreeter(opts ...Option) *Greeter {
+	g := &Greeter{locale: DetectLocale()}
+	for _, opt := range opts {
+		opt(g)
+	}
+	return g
+}
+
+// Greet writes a greeting for name to w using g's locale. It returns
+// ErrEmptyName if name is empty.
+func (g *Greeter) Greet(w io.Writer, name string) error {
+	if name == "" {
+		return ErrEmptyName
+	}
+
+	tmpl, ok := translations[g.locale]
+	if !ok {
+		tmpl = translations[DefaultLocale]
+	}
+
+	_, err := fmt.Fprintf(w, tmpl, name)
+	return err
+}
+
+var defaultGreeter = NewGreeter()
+
+// Greet writes a greeting for name to w using the default, environment-
+// detected locale. It returns ErrEmptyName if name is empty.
+func Greet(w io.Writer, name string) error {
+	return defaultGreeter.Greet(w, name)
 }
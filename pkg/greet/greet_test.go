@@ -1,29 +1,70 @@
 package greet
 
-import "testing"
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
 
-func TestHello(t *testing.T) {
+func TestGreeterGreet(t *testing.T) {
 	tests := []struct {
-		name string
-		want string
+		name   string
+		locale string
+		who    string
+		want   string
 	}{
-		{"World", "Hello, World!"},
-		{"Go", "Hello, Go!"},
-		{"", "Hello, !"},
+		{"english", "en", "World", "Hello, World!\n"},
+		{"spanish", "es", "Mundo", "¡Hola, Mundo!\n"},
+		{"french", "fr", "Bob", "Bonjour, Bob !\n"},
+		{"german", "de", "Welt", "Hallo, Welt!\n"},
+		{"japanese", "ja", "世界", "こんにちは、世界さん!\n"},
+		{"unknown locale falls back to default", "xx", "World", "Hello, World!\n"},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := Hello(tt.name)
-			if got != tt.want {
-				t.Errorf("Hello(%q) = %q, want %q", tt.name, got, tt.want)
+			g := NewGreeter(WithLocale(tt.locale))
+
+			var buf bytes.Buffer
+			if err := g.Greet(&buf, tt.who); err != nil {
+				t.Fatalf("Greet(%q) returned error: %v", tt.who, err)
+			}
+
+			if got := buf.String(); got != tt.want {
+				t.Errorf("Greet(%q) = %q, want %q", tt.who, got, tt.want)
 			}
 		})
 	}
 }
 
-func BenchmarkHello(b *testing.B) {
+func TestGreeterGreetEmptyName(t *testing.T) {
+	g := NewGreeter(WithLocale("en"))
+
+	var buf bytes.Buffer
+	err := g.Greet(&buf, "")
+	if !errors.Is(err, ErrEmptyName) {
+		t.Fatalf("Greet(\"\") error = %v, want %v", err, ErrEmptyName)
+	}
+	if buf.Len() != 0 {
+		t.Errorf("Greet(\"\") wrote %q, want nothing written", buf.String())
+	}
+}
+
+func TestGreet(t *testing.T) {
+	var buf bytes.Buffer
+	if err := Greet(&buf, "Go"); err != nil {
+		t.Fatalf("Greet(Go) returned error: %v", err)
+	}
+	if got, want := buf.String(), "Hello, Go!\n"; got != want {
+		t.Errorf("Greet(Go) = %q, want %q", got, want)
+	}
+}
+
+func BenchmarkGreet(b *testing.B) {
+	g := NewGreeter(WithLocale("en"))
+	var buf bytes.Buffer
 	for i := 0; i < b.N; i++ {
-		Hello("Benchmark")
+		buf.Reset()
+		_ = g.Greet(&buf, "Benchmark")
 	}
 }
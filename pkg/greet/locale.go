@@ -0,0 +1,52 @@
+package greet
+
+import (
+	"os"
+	"strings"
+)
+
+// translations maps a locale to a Printf-style greeting template taking the
+// recipient's name as its only argument.
+var translations = map[string]string{
+	"en": "Hello, %s!\n",
+	"es": "¡Hola, %s!\n",
+	"fr": "Bonjour, %s !\n",
+	"de": "Hallo, %s!\n",
+	"ja": "こんにちは、%sさん!\n",
+}
+
+// osGetenv is a var so tests can stub environment lookups without mutating
+// real process state.
+var osGetenv = os.Getenv
+
+// DetectLocale returns the two-letter locale derived from the LANG or
+// LC_ALL environment variables (e.g. "fr_FR.UTF-8" -> "fr"), falling back
+// to DefaultLocale if neither is set or recognized.
+func DetectLocale() string {
+	for _, env := range []string{"LC_ALL", "LANG"} {
+		if locale := localeFromEnv(osGetenv(env)); locale != "" {
+			return locale
+		}
+	}
+	return DefaultLocale
+}
+
+func localeFromEnv(value string) string {
+	if value == "" || value == "C" || value == "POSIX" {
+		return ""
+	}
+
+	lang := value
+	if i := strings.IndexAny(lang, ".@"); i >= 0 {
+		lang = lang[:i]
+	}
+	if i := strings.Index(lang, "_"); i >= 0 {
+		lang = lang[:i]
+	}
+	lang = strings.ToLower(lang)
+
+	if _, ok := translations[lang]; ok {
+		return lang
+	}
+	return ""
+}
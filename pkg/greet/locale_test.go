@@ -0,0 +1,32 @@
+package greet
+
+import "testing"
+
+func TestDetectLocale(t *testing.T) {
+	tests := []struct {
+		name  string
+		lcAll string
+		lang  string
+		want  string
+	}{
+		{"LC_ALL takes priority", "fr_FR.UTF-8", "de_DE.UTF-8", "fr"},
+		{"falls back to LANG", "", "es_ES.UTF-8", "es"},
+		{"strips encoding and territory", "ja_JP.UTF-8", "", "ja"},
+		{"unrecognized locale falls back to default", "", "xx_XX.UTF-8", DefaultLocale},
+		{"C locale falls back to default", "C", "", DefaultLocale},
+		{"nothing set falls back to default", "", "", DefaultLocale},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := map[string]string{"LC_ALL": tt.lcAll, "LANG": tt.lang}
+			orig := osGetenv
+			osGetenv = func(key string) string { return env[key] }
+			defer func() { osGetenv = orig }()
+
+			if got := DetectLocale(); got != tt.want {
+				t.Errorf("DetectLocale() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
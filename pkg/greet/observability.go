@@ -0,0 +1,147 @@
+package greet
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+)
+
+var tracer = otel.Tracer("github.com/example/go-workflow-template/pkg/greet")
+
+var (
+	obsMu   sync.RWMutex
+	logger  = slog.Default()
+	meter   = otel.GetMeterProvider().Meter("github.com/example/go-workflow-template/pkg/greet")
+	counter metric.Int64Counter
+	latency metric.Float64Histogram
+)
+
+// SetLogger configures the *slog.Logger used by GreetContext. Passing nil
+// restores the default logger.
+func SetLogger(l *slog.Logger) {
+	obsMu.Lock()
+	defer obsMu.Unlock()
+	if l == nil {
+		l = slog.Default()
+	}
+	logger = l
+}
+
+// SetMeter configures the OpenTelemetry metric.Meter used by GreetContext
+// to record the greeting counter and latency histogram. Passing nil
+// restores the global meter provider's meter.
+func SetMeter(m metric.Meter) {
+	obsMu.Lock()
+	defer obsMu.Unlock()
+	if m == nil {
+		m = otel.GetMeterProvider().Meter("github.com/example/go-workflow-template/pkg/greet")
+	}
+	meter = m
+	counter = nil
+	latency = nil
+}
+
+func instruments() (metric.Int64Counter, metric.Float64Histogram) {
+	obsMu.Lock()
+	defer obsMu.Unlock()
+
+	if counter == nil {
+		counter, _ = meter.Int64Counter(
+			"greet.count",
+			metric.WithDescription("Number of greetings produced"),
+		)
+	}
+	if latency == nil {
+		latency, _ = meter.Float64Histogram(
+			"greet.latency",
+			metric.WithDescription("Latency of greeting generation"),
+			metric.WithUnit("ms"),
+		)
+	}
+	return counter, latency
+}
+
+// GreetContext behaves like (*Greeter).Greet, but additionally emits a
+// structured log entry and an OpenTelemetry span and metrics for the call.
+func (g *Greeter) GreetContext(ctx context.Context, w io.Writer, name string) error {
+	ctx, span := tracer.Start(ctx, "greet.Greet")
+	defer span.End()
+
+	start := time.Now()
+	err := g.Greet(w, name)
+	elapsed := time.Since(start)
+
+	obsMu.RLock()
+	l := logger
+	obsMu.RUnlock()
+
+	attrs := []slog.Attr{
+		slog.String("name", name),
+		slog.String("locale", g.locale),
+		slog.Duration("duration", elapsed),
+	}
+	if err != nil {
+		attrs = append(attrs, slog.Any("error", err))
+		l.LogAttrs(ctx, slog.LevelError, "greet", attrs...)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	} else {
+		l.LogAttrs(ctx, slog.LevelInfo, "greet", attrs...)
+	}
+
+	c, h := instruments()
+	c.Add(ctx, 1, metric.WithAttributes(attribute.String("locale", g.locale)))
+	h.Record(ctx, float64(elapsed.Milliseconds()), metric.WithAttributes(attribute.String("locale", g.locale)))
+
+	return err
+}
+
+// GreetContext behaves like Greet, but additionally emits a structured log
+// entry and an OpenTelemetry span and metrics for the call, using the
+// default, environment-detected Greeter.
+func GreetContext(ctx context.Context, w io.Writer, name string) error {
+	return defaultGreeter.GreetContext(ctx, w, name)
+}
+
+// FormatContext behaves like Format, but additionally emits a structured
+// log entry and an OpenTelemetry span and metrics for the call, the same
+// way GreetContext does for Greet.
+func FormatContext(ctx context.Context, formatterName, name string) (string, error) {
+	ctx, span := tracer.Start(ctx, "greet.Format")
+	defer span.End()
+
+	start := time.Now()
+	out, err := Format(formatterName, name)
+	elapsed := time.Since(start)
+
+	obsMu.RLock()
+	l := logger
+	obsMu.RUnlock()
+
+	attrs := []slog.Attr{
+		slog.String("name", name),
+		slog.String("formatter", formatterName),
+		slog.Duration("duration", elapsed),
+	}
+	if err != nil {
+		attrs = append(attrs, slog.Any("error", err))
+		l.LogAttrs(ctx, slog.LevelError, "greet", attrs...)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	} else {
+		l.LogAttrs(ctx, slog.LevelInfo, "greet", attrs...)
+	}
+
+	c, h := instruments()
+	c.Add(ctx, 1, metric.WithAttributes(attribute.String("formatter", formatterName)))
+	h.Record(ctx, float64(elapsed.Milliseconds()), metric.WithAttributes(attribute.String("formatter", formatterName)))
+
+	return out, err
+}
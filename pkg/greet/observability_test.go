@@ -0,0 +1,133 @@
+package greet
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"testing"
+
+	"go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestGreetContextLogsAndRecords(t *testing.T) {
+	var logBuf bytes.Buffer
+	SetLogger(slog.New(slog.NewJSONHandler(&logBuf, nil)))
+	defer SetLogger(nil)
+
+	spanRecorder := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spanRecorder))
+	origTracer := tracer
+	tracer = tp.Tracer("test")
+	defer func() { tracer = origTracer }()
+
+	reader := metric.NewManualReader()
+	mp := metric.NewMeterProvider(metric.WithReader(reader))
+	SetMeter(mp.Meter("test"))
+	defer SetMeter(nil)
+
+	g := NewGreeter(WithLocale("en"))
+	var buf bytes.Buffer
+	if err := g.GreetContext(context.Background(), &buf, "Bob"); err != nil {
+		t.Fatalf("GreetContext returned error: %v", err)
+	}
+
+	if logBuf.Len() == 0 {
+		t.Error("expected a log entry to be written")
+	}
+
+	spans := spanRecorder.Ended()
+	if len(spans) != 1 {
+		t.Fatalf("got %d spans, want 1", len(spans))
+	}
+	if got := spans[0].Name(); got != "greet.Greet" {
+		t.Errorf("span name = %q, want %q", got, "greet.Greet")
+	}
+
+	var rm metricdata.ResourceMetrics
+	if err := reader.Collect(context.Background(), &rm); err != nil {
+		t.Fatalf("Collect() returned error: %v", err)
+	}
+
+	sum, ok := counterSum(rm, "greet.count")
+	if !ok {
+		t.Fatal("greet.count metric was not recorded")
+	}
+	if sum != 1 {
+		t.Errorf("greet.count sum = %d, want 1", sum)
+	}
+}
+
+func TestFormatContextLogsAndRecords(t *testing.T) {
+	var logBuf bytes.Buffer
+	SetLogger(slog.New(slog.NewJSONHandler(&logBuf, nil)))
+	defer SetLogger(nil)
+
+	spanRecorder := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spanRecorder))
+	origTracer := tracer
+	tracer = tp.Tracer("test")
+	defer func() { tracer = origTracer }()
+
+	reader := metric.NewManualReader()
+	mp := metric.NewMeterProvider(metric.WithReader(reader))
+	SetMeter(mp.Meter("test"))
+	defer SetMeter(nil)
+
+	out, err := FormatContext(context.Background(), "json", "Bob")
+	if err != nil {
+		t.Fatalf("FormatContext returned error: %v", err)
+	}
+	if want := `{"greeting":"Hello","recipient":"Bob"}`; out != want {
+		t.Errorf("FormatContext output = %q, want %q", out, want)
+	}
+
+	if logBuf.Len() == 0 {
+		t.Error("expected a log entry to be written")
+	}
+
+	spans := spanRecorder.Ended()
+	if len(spans) != 1 {
+		t.Fatalf("got %d spans, want 1", len(spans))
+	}
+	if got := spans[0].Name(); got != "greet.Format" {
+		t.Errorf("span name = %q, want %q", got, "greet.Format")
+	}
+
+	var rm metricdata.ResourceMetrics
+	if err := reader.Collect(context.Background(), &rm); err != nil {
+		t.Fatalf("Collect() returned error: %v", err)
+	}
+
+	sum, ok := counterSum(rm, "greet.count")
+	if !ok {
+		t.Fatal("greet.count metric was not recorded")
+	}
+	if sum != 1 {
+		t.Errorf("greet.count sum = %d, want 1", sum)
+	}
+}
+
+// counterSum returns the total of an Int64 sum metric named name across all
+// its data points, and whether the metric was found at all.
+func counterSum(rm metricdata.ResourceMetrics, name string) (int64, bool) {
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			if m.Name != name {
+				continue
+			}
+			sum, ok := m.Data.(metricdata.Sum[int64])
+			if !ok {
+				return 0, false
+			}
+			var total int64
+			for _, dp := range sum.DataPoints {
+				total += dp.Value
+			}
+			return total, true
+		}
+	}
+	return 0, false
+}
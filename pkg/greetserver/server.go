@@ -0,0 +1,148 @@
+// Package greetserver exposes greet.Greet over HTTP and gRPC.
+package greetserver
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+
+	"google.golang.org/grpc"
+
+	"github.com/example/go-workflow-template/pkg/greet"
+	"github.com/example/go-workflow-template/pkg/greetserver/greetpb"
+)
+
+// Config controls which listeners Serve starts. An empty address disables
+// that listener.
+type Config struct {
+	HTTPAddr string
+	GRPCAddr string
+}
+
+// Server hosts the greet service over HTTP and gRPC.
+type Server struct {
+	greeter *greet.Greeter
+
+	httpSrv  *http.Server
+	grpcSrv  *grpc.Server
+	listener net.Listener
+}
+
+// New builds a Server that answers with g. If g is nil, a default,
+// environment-detected Greeter is used.
+func New(cfg Config, g *greet.Greeter) (*Server, error) {
+	if g == nil {
+		g = greet.NewGreeter()
+	}
+	s := &Server{greeter: g}
+
+	if cfg.HTTPAddr != "" {
+		mux := http.NewServeMux()
+		mux.HandleFunc("/greet", s.handleGreet)
+		s.httpSrv = &http.Server{Addr: cfg.HTTPAddr, Handler: mux}
+	}
+
+	if cfg.GRPCAddr != "" {
+		lis, err := net.Listen("tcp", cfg.GRPCAddr)
+		if err != nil {
+			return nil, fmt.Errorf("greetserver: listen on %s: %w", cfg.GRPCAddr, err)
+		}
+		grpcSrv := grpc.NewServer()
+		greetpb.RegisterGreeterServer(grpcSrv, &grpcService{greeter: g})
+		s.grpcSrv = grpcSrv
+		s.listener = lis
+	}
+
+	return s, nil
+}
+
+// Serve starts any configured listeners and blocks until ctx is canceled,
+// then shuts both servers down gracefully.
+func (s *Server) Serve(ctx context.Context) error {
+	errCh := make(chan error, 2)
+
+	if s.httpSrv != nil {
+		go func() {
+			if err := s.httpSrv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				errCh <- fmt.Errorf("greetserver: http server: %w", err)
+				return
+			}
+			errCh <- nil
+		}()
+	}
+
+	if s.grpcSrv != nil {
+		go func() {
+			if err := s.grpcSrv.Serve(s.listener); err != nil {
+				errCh <- fmt.Errorf("greetserver: grpc server: %w", err)
+				return
+			}
+			errCh <- nil
+		}()
+	}
+
+	select {
+	case <-ctx.Done():
+	case err := <-errCh:
+		if err != nil {
+			s.Shutdown(context.Background())
+			return err
+		}
+	}
+
+	return s.Shutdown(context.Background())
+}
+
+// Shutdown gracefully stops any running listeners.
+func (s *Server) Shutdown(ctx context.Context) error {
+	var err error
+	if s.httpSrv != nil {
+		err = s.httpSrv.Shutdown(ctx)
+	}
+	if s.grpcSrv != nil {
+		s.grpcSrv.GracefulStop()
+	}
+	return err
+}
+
+type greetResponse struct {
+	Message string `json:"message"`
+}
+
+func (s *Server) handleGreet(w http.ResponseWriter, r *http.Request) {
+	name := r.URL.Query().Get("name")
+
+	var buf bytes.Buffer
+	if err := s.greeter.GreetContext(r.Context(), &buf, name); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(greetResponse{Message: trimNewline(buf.String())})
+}
+
+func trimNewline(s string) string {
+	if len(s) > 0 && s[len(s)-1] == '\n' {
+		return s[:len(s)-1]
+	}
+	return s
+}
+
+// grpcService adapts a greet.Greeter to the greetpb.GreeterServer interface.
+type grpcService struct {
+	greetpb.UnimplementedGreeterServer
+	greeter *greet.Greeter
+}
+
+func (g *grpcService) Greet(ctx context.Context, req *greetpb.GreetRequest) (*greetpb.GreetReply, error) {
+	var buf bytes.Buffer
+	if err := g.greeter.GreetContext(ctx, &buf, req.GetName()); err != nil {
+		return nil, err
+	}
+	return &greetpb.GreetReply{Message: trimNewline(buf.String())}, nil
+}
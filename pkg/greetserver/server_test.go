@@ -0,0 +1,116 @@
+package greetserver
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net"
+	"net/http/httptest"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/example/go-workflow-template/pkg/greet"
+	"github.com/example/go-workflow-template/pkg/greetserver/greetpb"
+)
+
+func TestHandleGreetHTTP(t *testing.T) {
+	s, err := New(Config{}, greet.NewGreeter(greet.WithLocale("en")))
+	if err != nil {
+		t.Fatalf("New() returned error: %v", err)
+	}
+
+	req := httptest.NewRequest("GET", "/greet?name=Bob", nil)
+	rec := httptest.NewRecorder()
+	s.handleGreet(rec, req)
+
+	if rec.Code != 200 {
+		t.Fatalf("handleGreet status = %d, want 200", rec.Code)
+	}
+
+	var got greetResponse
+	if err := json.NewDecoder(rec.Body).Decode(&got); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+	if want := "Hello, Bob!"; got.Message != want {
+		t.Errorf("handleGreet message = %q, want %q", got.Message, want)
+	}
+}
+
+func TestHandleGreetHTTPEmptyName(t *testing.T) {
+	s, err := New(Config{}, greet.NewGreeter(greet.WithLocale("en")))
+	if err != nil {
+		t.Fatalf("New() returned error: %v", err)
+	}
+
+	req := httptest.NewRequest("GET", "/greet", nil)
+	rec := httptest.NewRecorder()
+	s.handleGreet(rec, req)
+
+	if rec.Code != 400 {
+		t.Errorf("handleGreet status = %d, want 400", rec.Code)
+	}
+}
+
+func TestHandleGreetHTTPLogsObservability(t *testing.T) {
+	var logBuf bytes.Buffer
+	greet.SetLogger(slog.New(slog.NewJSONHandler(&logBuf, nil)))
+	defer greet.SetLogger(nil)
+
+	s, err := New(Config{}, greet.NewGreeter(greet.WithLocale("en")))
+	if err != nil {
+		t.Fatalf("New() returned error: %v", err)
+	}
+
+	req := httptest.NewRequest("GET", "/greet?name=Bob", nil)
+	rec := httptest.NewRecorder()
+	s.handleGreet(rec, req)
+
+	if logBuf.Len() == 0 {
+		t.Error("expected handleGreet to emit a structured log entry via GreetContext")
+	}
+}
+
+func TestGRPCGreetLogsObservability(t *testing.T) {
+	var logBuf bytes.Buffer
+	greet.SetLogger(slog.New(slog.NewJSONHandler(&logBuf, nil)))
+	defer greet.SetLogger(nil)
+
+	g := &grpcService{greeter: greet.NewGreeter(greet.WithLocale("en"))}
+	if _, err := g.Greet(context.Background(), &greetpb.GreetRequest{Name: "Go"}); err != nil {
+		t.Fatalf("Greet() returned error: %v", err)
+	}
+
+	if logBuf.Len() == 0 {
+		t.Error("expected grpcService.Greet to emit a structured log entry via GreetContext")
+	}
+}
+
+func TestGRPCGreet(t *testing.T) {
+	lis := bufconn.Listen(1024 * 1024)
+	grpcSrv := grpc.NewServer()
+	greetpb.RegisterGreeterServer(grpcSrv, &grpcService{greeter: greet.NewGreeter(greet.WithLocale("en"))})
+	go grpcSrv.Serve(lis)
+	defer grpcSrv.Stop()
+
+	dialer := func(context.Context, string) (net.Conn, error) { return lis.Dial() }
+	conn, err := grpc.DialContext(context.Background(), "bufnet",
+		grpc.WithContextDialer(dialer),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("grpc.DialContext: %v", err)
+	}
+	defer conn.Close()
+
+	client := greetpb.NewGreeterClient(conn)
+	reply, err := client.Greet(context.Background(), &greetpb.GreetRequest{Name: "Go"})
+	if err != nil {
+		t.Fatalf("Greet() returned error: %v", err)
+	}
+	if want := "Hello, Go!"; reply.GetMessage() != want {
+		t.Errorf("Greet().Message = %q, want %q", reply.GetMessage(), want)
+	}
+}